@@ -0,0 +1,111 @@
+package result
+
+import (
+	"context"
+	"errors"
+)
+
+// WrapCtx is like Wrap, but treats ctx as cancelled work: if ctx carries a non-nil error,
+// it takes precedence over err.
+func WrapCtx[T any](ctx context.Context, some T, err error) Result[T] {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return Err[T](ctxErr)
+	}
+	return Wrap(some, err)
+}
+
+// Future is a Result[T] that is still being computed on another goroutine. It is safe to
+// Await (or AwaitCtx) the same Future any number of times, concurrently or otherwise; every
+// caller observes the same Result once it is ready.
+type Future[T any] struct {
+	done chan struct{}
+	res  *Result[T]
+}
+
+// Go runs f on a new goroutine and returns a Future for its eventual Result.
+func Go[T any](f func() Result[T]) Future[T] {
+	done := make(chan struct{})
+	res := new(Result[T])
+	go func() {
+		*res = f()
+		close(done)
+	}()
+	return Future[T]{done: done, res: res}
+}
+
+// Await blocks until the Future's Result is available.
+func (f Future[T]) Await() Result[T] {
+	<-f.done
+	return *f.res
+}
+
+// AwaitCtx blocks until the Future's Result is available or ctx is done, whichever comes
+// first, returning Err(ctx.Err()) in the latter case.
+func (f Future[T]) AwaitCtx(ctx context.Context) Result[T] {
+	select {
+	case <-f.done:
+		return *f.res
+	case <-ctx.Done():
+		return Err[T](ctx.Err())
+	}
+}
+
+// Select awaits every future concurrently and returns the first Ok encountered, or, if
+// none of them succeed, the last Err encountered.
+func Select[T any](futures ...Future[T]) Result[T] {
+	if len(futures) == 0 {
+		return Err[T](errors.New("result: Select called with no futures"))
+	}
+	done := make(chan Result[T], len(futures))
+	for _, f := range futures {
+		f := f
+		go func() { done <- f.Await() }()
+	}
+	var last Result[T]
+	for range futures {
+		r := <-done
+		if r.IsOk() {
+			return r
+		}
+		last = r
+	}
+	return last
+}
+
+type indexedResult[T any] struct {
+	i int
+	r Result[T]
+}
+
+// JoinAll awaits every future concurrently and collects their values in positional order.
+// It fails fast: as soon as any future lands an Err, JoinAll returns it immediately, without
+// waiting on the futures that are still running.
+func JoinAll[T any](futures ...Future[T]) Result[[]T] {
+	if len(futures) == 0 {
+		return Ok([]T{})
+	}
+	out := make([]T, len(futures))
+	done := make(chan indexedResult[T], len(futures))
+	for i, f := range futures {
+		i, f := i, f
+		go func() { done <- indexedResult[T]{i: i, r: f.Await()} }()
+	}
+	for range futures {
+		ir := <-done
+		if ir.r.IsErr() {
+			return Err[[]T](ir.r.err)
+		}
+		out[ir.i] = ir.r.ok
+	}
+	return Ok(out)
+}
+
+// JoinAllSettled awaits every future, always waiting for all of them, and partitions
+// their Ok values from their errors.
+func JoinAllSettled[T any](futures ...Future[T]) ([]T, []error) {
+	rs := make([]Result[T], len(futures))
+	for i, f := range futures {
+		rs[i] = f.Await()
+	}
+	return Partition(rs)
+}
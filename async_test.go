@@ -0,0 +1,99 @@
+package result
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func delayed[T any](d time.Duration, r Result[T]) Future[T] {
+	return Go(func() Result[T] {
+		time.Sleep(d)
+		return r
+	})
+}
+
+func TestSelectFirstOkWins(t *testing.T) {
+	slow := delayed(30*time.Millisecond, Ok(1))
+	fast := delayed(5*time.Millisecond, Ok(2))
+	failing := delayed(0, Err[int](errors.New("nope")))
+
+	got := Select(failing, slow, fast)
+	if got.IsErr() || got.Unwrap() != 2 {
+		t.Fatalf("Select = %v, want Ok(2)", got)
+	}
+}
+
+func TestSelectAllErrReturnsLast(t *testing.T) {
+	first := delayed(0, Err[int](errors.New("first")))
+	second := delayed(10*time.Millisecond, Err[int](errors.New("second")))
+
+	got := Select(first, second)
+	if !got.IsErr() || got.UnwrapErr().Error() != "second" {
+		t.Fatalf("Select = %v, want Err(second)", got)
+	}
+}
+
+func TestJoinAllFailsFastWithoutWaitingOnSlowFutures(t *testing.T) {
+	slow := delayed(2*time.Second, Ok(1))
+	fastErr := delayed(5*time.Millisecond, Err[int](errors.New("boom")))
+
+	start := time.Now()
+	got := JoinAll(slow, fastErr)
+	elapsed := time.Since(start)
+
+	if !got.IsErr() || got.UnwrapErr().Error() != "boom" {
+		t.Fatalf("JoinAll = %v, want Err(boom)", got)
+	}
+	if elapsed >= time.Second {
+		t.Fatalf("JoinAll took %v, want it to return as soon as the fast future errors", elapsed)
+	}
+}
+
+func TestJoinAllPreservesOrderOnSuccess(t *testing.T) {
+	a := delayed(10*time.Millisecond, Ok(1))
+	b := delayed(0, Ok(2))
+	c := delayed(5*time.Millisecond, Ok(3))
+
+	got := JoinAll(a, b, c)
+	if got.IsErr() {
+		t.Fatalf("JoinAll = %v, want Ok", got)
+	}
+	want := []int{1, 2, 3}
+	vals := got.Unwrap()
+	for i := range want {
+		if vals[i] != want[i] {
+			t.Fatalf("JoinAll values = %v, want %v", vals, want)
+		}
+	}
+}
+
+func TestJoinAllSettledCollectsEverything(t *testing.T) {
+	a := delayed(0, Ok(1))
+	b := delayed(0, Err[int](errors.New("bad")))
+	c := delayed(0, Ok(3))
+
+	oks, errs := JoinAllSettled(a, b, c)
+	if len(oks) != 2 || len(errs) != 1 {
+		t.Fatalf("JoinAllSettled = (%v, %v), want 2 oks and 1 err", oks, errs)
+	}
+}
+
+func TestFutureAwaitIsSafeForMultipleConcurrentCallers(t *testing.T) {
+	f := delayed(5*time.Millisecond, Ok(9))
+
+	results := make(chan Result[int], 2)
+	go func() { results <- f.Await() }()
+	go func() { results <- f.Await() }()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case r := <-results:
+			if r.IsErr() || r.Unwrap() != 9 {
+				t.Fatalf("Await = %v, want Ok(9)", r)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Await did not return for all concurrent callers")
+		}
+	}
+}
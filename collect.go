@@ -0,0 +1,76 @@
+package result
+
+// Collect turns a []Result[T] into a Result[[]T]: Ok of all the values if every element
+// is Ok, or the first Err encountered. This is the "sequence" operation from the Rust
+// ecosystem, and the usual way to turn a batch of fallible computations into one result.
+func Collect[T any](rs []Result[T]) Result[[]T] {
+	out := make([]T, 0, len(rs))
+	for _, r := range rs {
+		if r.IsErr() {
+			return Err[[]T](r.err)
+		}
+		out = append(out, r.ok)
+	}
+	return Ok(out)
+}
+
+// CollectMap turns a map[K]Result[V] into a Result[map[K]V]: Ok of all the values if every
+// entry is Ok, or the first Err encountered. Iteration order, and therefore which Err wins
+// when there is more than one, is unspecified.
+func CollectMap[K comparable, V any](m map[K]Result[V]) Result[map[K]V] {
+	out := make(map[K]V, len(m))
+	for k, r := range m {
+		if r.IsErr() {
+			return Err[map[K]V](r.err)
+		}
+		out[k] = r.ok
+	}
+	return Ok(out)
+}
+
+// CollectChan drains ch and turns it into a Result[[]T]: Ok of all the values received if
+// every one is Ok, or the first Err encountered. It stops draining as soon as an Err is
+// received, leaving any remaining values on ch unread.
+func CollectChan[T any](ch <-chan Result[T]) Result[[]T] {
+	var out []T
+	for r := range ch {
+		if r.IsErr() {
+			return Err[[]T](r.err)
+		}
+		out = append(out, r.ok)
+	}
+	return Ok(out)
+}
+
+// Partition splits a []Result[T] into its Ok values and its Err values, without
+// short-circuiting on the first Err.
+func Partition[T any](rs []Result[T]) (oks []T, errs []error) {
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.err)
+		} else {
+			oks = append(oks, r.ok)
+		}
+	}
+	return oks, errs
+}
+
+// Any returns true if at least one Result in rs is Ok.
+func Any[T any](rs []Result[T]) bool {
+	for _, r := range rs {
+		if r.IsOk() {
+			return true
+		}
+	}
+	return false
+}
+
+// All returns true if every Result in rs is Ok. All returns true for an empty rs.
+func All[T any](rs []Result[T]) bool {
+	for _, r := range rs {
+		if r.IsErr() {
+			return false
+		}
+	}
+	return true
+}
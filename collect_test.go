@@ -0,0 +1,112 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCollectAllOk(t *testing.T) {
+	got := Collect([]Result[int]{Ok(1), Ok(2), Ok(3)})
+	if got.IsErr() {
+		t.Fatalf("Collect = %v, want Ok", got)
+	}
+	vals := got.Unwrap()
+	if len(vals) != 3 || vals[0] != 1 || vals[2] != 3 {
+		t.Fatalf("Collect = %v, want [1 2 3]", vals)
+	}
+}
+
+func TestCollectShortCircuits(t *testing.T) {
+	errBoom := errors.New("boom")
+	got := Collect([]Result[int]{Ok(1), Err[int](errBoom), Ok(3)})
+	if !got.IsErr() || !errors.Is(got.UnwrapErr(), errBoom) {
+		t.Fatalf("Collect = %v, want Err(boom)", got)
+	}
+}
+
+func TestCollectMapAllOk(t *testing.T) {
+	got := CollectMap(map[string]Result[int]{"a": Ok(1), "b": Ok(2)})
+	if got.IsErr() {
+		t.Fatalf("CollectMap = %v, want Ok", got)
+	}
+	m := got.Unwrap()
+	if m["a"] != 1 || m["b"] != 2 {
+		t.Fatalf("CollectMap = %v, want {a:1 b:2}", m)
+	}
+}
+
+func TestCollectMapShortCircuits(t *testing.T) {
+	errBoom := errors.New("boom")
+	got := CollectMap(map[string]Result[int]{"a": Ok(1), "b": Err[int](errBoom)})
+	if !got.IsErr() || !errors.Is(got.UnwrapErr(), errBoom) {
+		t.Fatalf("CollectMap = %v, want Err(boom)", got)
+	}
+}
+
+func TestCollectChanAllOk(t *testing.T) {
+	ch := make(chan Result[int], 3)
+	ch <- Ok(1)
+	ch <- Ok(2)
+	ch <- Ok(3)
+	close(ch)
+
+	got := CollectChan(ch)
+	if got.IsErr() {
+		t.Fatalf("CollectChan = %v, want Ok", got)
+	}
+	vals := got.Unwrap()
+	if len(vals) != 3 || vals[0] != 1 || vals[2] != 3 {
+		t.Fatalf("CollectChan = %v, want [1 2 3]", vals)
+	}
+}
+
+func TestCollectChanShortCircuits(t *testing.T) {
+	errBoom := errors.New("boom")
+	ch := make(chan Result[int], 3)
+	ch <- Ok(1)
+	ch <- Err[int](errBoom)
+	ch <- Ok(3)
+	close(ch)
+
+	got := CollectChan(ch)
+	if !got.IsErr() || !errors.Is(got.UnwrapErr(), errBoom) {
+		t.Fatalf("CollectChan = %v, want Err(boom)", got)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	errBoom := errors.New("boom")
+	oks, errs := Partition([]Result[int]{Ok(1), Err[int](errBoom), Ok(3)})
+	if len(oks) != 2 || oks[0] != 1 || oks[1] != 3 {
+		t.Fatalf("Partition oks = %v, want [1 3]", oks)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], errBoom) {
+		t.Fatalf("Partition errs = %v, want [boom]", errs)
+	}
+}
+
+func TestAny(t *testing.T) {
+	errBoom := errors.New("boom")
+	if !Any([]Result[int]{Err[int](errBoom), Ok(1)}) {
+		t.Fatal("Any = false, want true")
+	}
+	if Any([]Result[int]{Err[int](errBoom), Err[int](errBoom)}) {
+		t.Fatal("Any = true, want false")
+	}
+	if Any([]Result[int]{}) {
+		t.Fatal("Any([]) = true, want false")
+	}
+}
+
+func TestAll(t *testing.T) {
+	errBoom := errors.New("boom")
+	if !All([]Result[int]{Ok(1), Ok(2)}) {
+		t.Fatal("All = false, want true")
+	}
+	if All([]Result[int]{Ok(1), Err[int](errBoom)}) {
+		t.Fatal("All = true, want false")
+	}
+	if !All([]Result[int]{}) {
+		t.Fatal("All([]) = false, want true")
+	}
+}
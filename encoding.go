@@ -0,0 +1,86 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrorEncoder controls how the error half of a Result is turned into an encodable value
+// when marshaling to JSON, text or gob. The default encodes only the error's message;
+// set it to e.g. `func(err error) any { return Result[any]{err: err}.ErrVal() }` to
+// serialize structured errors instead.
+var ErrorEncoder = func(err error) any {
+	return err.Error()
+}
+
+// ErrorDecoder reconstructs an error from the value produced by ErrorEncoder, when
+// decoding. The default wraps a string message in errors.New.
+var ErrorDecoder = func(v any) error {
+	if msg, ok := v.(string); ok {
+		return errors.New(msg)
+	}
+	return fmt.Errorf("%v", v)
+}
+
+// MarshalJSON encodes an Ok value as {"ok": <value>} and an Err value as
+// {"err": <ErrorEncoder(err)>}.
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.IsOk() {
+		return json.Marshal(struct {
+			Ok T `json:"ok"`
+		}{Ok: r.ok})
+	}
+	return json.Marshal(struct {
+		Err any `json:"err"`
+	}{Err: ErrorEncoder(r.err)})
+}
+
+// UnmarshalJSON decodes the {"ok": <value>} / {"err": <value>} representation produced
+// by MarshalJSON. The err value is passed through ErrorDecoder to reconstruct an error.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	var tagged struct {
+		Ok  json.RawMessage `json:"ok"`
+		Err json.RawMessage `json:"err"`
+	}
+	if err := json.Unmarshal(data, &tagged); err != nil {
+		return err
+	}
+	switch {
+	case tagged.Ok != nil:
+		var v T
+		if err := json.Unmarshal(tagged.Ok, &v); err != nil {
+			return err
+		}
+		*r = Ok(v)
+	case tagged.Err != nil:
+		var v any
+		if err := json.Unmarshal(tagged.Err, &v); err != nil {
+			return err
+		}
+		*r = Err[T](ErrorDecoder(v))
+	default:
+		return fmt.Errorf("result: neither \"ok\" nor \"err\" present in %s", data)
+	}
+	return nil
+}
+
+// MarshalText encodes r using the same tagged representation as MarshalJSON.
+func (r Result[T]) MarshalText() ([]byte, error) {
+	return r.MarshalJSON()
+}
+
+// UnmarshalText decodes text produced by MarshalText.
+func (r *Result[T]) UnmarshalText(text []byte) error {
+	return r.UnmarshalJSON(text)
+}
+
+// GobEncode encodes r using the same tagged representation as MarshalJSON.
+func (r Result[T]) GobEncode() ([]byte, error) {
+	return r.MarshalJSON()
+}
+
+// GobDecode decodes data produced by GobEncode.
+func (r *Result[T]) GobDecode(data []byte) error {
+	return r.UnmarshalJSON(data)
+}
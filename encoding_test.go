@@ -0,0 +1,107 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestResultJSONRoundTripOk(t *testing.T) {
+	r := Ok(42)
+	data, err := r.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	if got, want := string(data), `{"ok":42}`; got != want {
+		t.Fatalf("MarshalJSON = %s, want %s", got, want)
+	}
+
+	var got Result[int]
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if got.IsErr() || got.Unwrap() != 42 {
+		t.Fatalf("round-tripped Result = %v, want Ok(42)", got)
+	}
+}
+
+func TestResultJSONRoundTripErr(t *testing.T) {
+	r := Err[int](errors.New("boom"))
+	data, err := r.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	if got, want := string(data), `{"err":"boom"}`; got != want {
+		t.Fatalf("MarshalJSON = %s, want %s", got, want)
+	}
+
+	var got Result[int]
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if !got.IsErr() || got.UnwrapErr().Error() != "boom" {
+		t.Fatalf("round-tripped Result = %v, want Err(boom)", got)
+	}
+}
+
+type customErr struct {
+	Code int `json:"code"`
+}
+
+func TestResultJSONCustomErrorCodec(t *testing.T) {
+	origEncoder, origDecoder := ErrorEncoder, ErrorDecoder
+	defer func() {
+		ErrorEncoder = origEncoder
+		ErrorDecoder = origDecoder
+	}()
+
+	ErrorEncoder = func(err error) any {
+		var ce customErr
+		if errors.As(err, &ce) {
+			return ce
+		}
+		return err.Error()
+	}
+	ErrorDecoder = func(v any) error {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return errors.New("result: invalid encoded error")
+		}
+		code, _ := m["code"].(float64)
+		return customErr{Code: int(code)}
+	}
+
+	r := Err[int](customErr{Code: 7})
+	data, err := r.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	if got, want := string(data), `{"err":{"code":7}}`; got != want {
+		t.Fatalf("MarshalJSON = %s, want %s", got, want)
+	}
+
+	var got Result[int]
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	var ce customErr
+	if !errors.As(got.UnwrapErr(), &ce) || ce.Code != 7 {
+		t.Fatalf("round-tripped error = %v, want customErr{Code: 7}", got.UnwrapErr())
+	}
+}
+
+func (e customErr) Error() string { return "custom error" }
+
+func TestResultGobRoundTrip(t *testing.T) {
+	r := Ok("hello")
+	data, err := r.GobEncode()
+	if err != nil {
+		t.Fatalf("GobEncode: %v", err)
+	}
+	var got Result[string]
+	if err := got.GobDecode(data); err != nil {
+		t.Fatalf("GobDecode: %v", err)
+	}
+	if got.IsErr() || got.Unwrap() != "hello" {
+		t.Fatalf("round-tripped Result = %v, want Ok(hello)", got)
+	}
+}
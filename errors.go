@@ -0,0 +1,36 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+)
+
+// errorWithVal wraps an arbitrary non-error value passed to Err, preserving it for ErrVal
+// while still satisfying the error interface.
+type errorWithVal struct {
+	val any
+	err error
+}
+
+func (e *errorWithVal) Error() string {
+	return e.err.Error()
+}
+
+func (e *errorWithVal) Unwrap() error {
+	return e.err
+}
+
+// newAnyError normalizes the any accepted by Err into an error, preserving the original
+// value when it isn't already an error or a string so ErrVal can recover it.
+func newAnyError(v any) error {
+	switch val := v.(type) {
+	case nil:
+		return nil
+	case error:
+		return val
+	case string:
+		return errors.New(val)
+	default:
+		return &errorWithVal{val: val, err: fmt.Errorf("%v", val)}
+	}
+}
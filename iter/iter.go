@@ -0,0 +1,194 @@
+// Package iter provides a lazy, pull-based Iterator[T] plus the common adapters,
+// and ties them to Result[T] so fallible sequences can be folded and collected
+// with short-circuiting on the first error.
+package iter
+
+import (
+	"github.com/uptutu/result"
+)
+
+// Iterator yields a lazy sequence of T, one value at a time, until exhausted.
+type Iterator[T any] interface {
+	// Next returns the next value, or None once the sequence is exhausted.
+	Next() result.Option[T]
+}
+
+type funcIterator[T any] struct {
+	next func() result.Option[T]
+}
+
+func (f *funcIterator[T]) Next() result.Option[T] {
+	return f.next()
+}
+
+// FromFunc builds an Iterator[T] out of a bare next function.
+func FromFunc[T any](next func() result.Option[T]) Iterator[T] {
+	return &funcIterator[T]{next: next}
+}
+
+// FromSlice builds an Iterator[T] that yields the elements of s in order.
+func FromSlice[T any](s []T) Iterator[T] {
+	i := 0
+	return FromFunc(func() result.Option[T] {
+		if i >= len(s) {
+			return result.None[T]()
+		}
+		v := s[i]
+		i++
+		return result.Some(v)
+	})
+}
+
+// FromChannel builds an Iterator[T] that yields values received from ch until it is closed.
+func FromChannel[T any](ch <-chan T) Iterator[T] {
+	return FromFunc(func() result.Option[T] {
+		v, ok := <-ch
+		if !ok {
+			return result.None[T]()
+		}
+		return result.Some(v)
+	})
+}
+
+// Map returns an Iterator[U] that lazily applies f to every value yielded by it.
+func Map[T any, U any](it Iterator[T], f func(T) U) Iterator[U] {
+	return FromFunc(func() result.Option[U] {
+		return result.OptionMap(it.Next(), f)
+	})
+}
+
+// Filter returns an Iterator[T] that only yields values from it matching pred.
+func Filter[T any](it Iterator[T], pred func(T) bool) Iterator[T] {
+	return FromFunc(func() result.Option[T] {
+		for v := it.Next(); ; v = it.Next() {
+			if v.IsNone() || pred(v.Unwrap()) {
+				return v
+			}
+		}
+	})
+}
+
+// Take returns an Iterator[T] that yields at most n values from it.
+func Take[T any](it Iterator[T], n int) Iterator[T] {
+	remaining := n
+	return FromFunc(func() result.Option[T] {
+		if remaining <= 0 {
+			return result.None[T]()
+		}
+		remaining--
+		return it.Next()
+	})
+}
+
+// Skip returns an Iterator[T] that drops the first n values from it before yielding the rest.
+func Skip[T any](it Iterator[T], n int) Iterator[T] {
+	skipped := false
+	return FromFunc(func() result.Option[T] {
+		if !skipped {
+			skipped = true
+			for i := 0; i < n; i++ {
+				if it.Next().IsNone() {
+					break
+				}
+			}
+		}
+		return it.Next()
+	})
+}
+
+// Chain returns an Iterator[T] that yields every value of a followed by every value of b.
+func Chain[T any](a, b Iterator[T]) Iterator[T] {
+	aDone := false
+	return FromFunc(func() result.Option[T] {
+		if !aDone {
+			if v := a.Next(); v.IsSome() {
+				return v
+			}
+			aDone = true
+		}
+		return b.Next()
+	})
+}
+
+// Pair is the element type yielded by Zip.
+type Pair[T any, U any] struct {
+	First  T
+	Second U
+}
+
+// Zip returns an Iterator[Pair[T, U]] that yields paired values from a and b, stopping as
+// soon as either one is exhausted.
+func Zip[T any, U any](a Iterator[T], b Iterator[U]) Iterator[Pair[T, U]] {
+	return FromFunc(func() result.Option[Pair[T, U]] {
+		av := a.Next()
+		if av.IsNone() {
+			return result.None[Pair[T, U]]()
+		}
+		bv := b.Next()
+		if bv.IsNone() {
+			return result.None[Pair[T, U]]()
+		}
+		return result.Some(Pair[T, U]{First: av.Unwrap(), Second: bv.Unwrap()})
+	})
+}
+
+// Fold drives it to exhaustion, threading an accumulator through f.
+func Fold[T any, U any](it Iterator[T], init U, f func(U, T) U) U {
+	acc := init
+	for v := it.Next(); v.IsSome(); v = it.Next() {
+		acc = f(acc, v.Unwrap())
+	}
+	return acc
+}
+
+// Collect drives it to exhaustion and returns the yielded values as a slice.
+func Collect[T any](it Iterator[T]) []T {
+	var out []T
+	for v := it.Next(); v.IsSome(); v = it.Next() {
+		out = append(out, v.Unwrap())
+	}
+	return out
+}
+
+// TryFold drives it to exhaustion, threading an accumulator through f, and short-circuits
+// as soon as f returns an Err.
+func TryFold[T any, U any](it Iterator[T], init U, f func(U, T) result.Result[U]) result.Result[U] {
+	acc := init
+	for v := it.Next(); v.IsSome(); v = it.Next() {
+		r := f(acc, v.Unwrap())
+		if r.IsErr() {
+			return r
+		}
+		acc = r.Unwrap()
+	}
+	return result.Ok(acc)
+}
+
+// TryCollect drives it to exhaustion and collects the Ok values into a slice, short-circuiting
+// on the first Err.
+func TryCollect[T any](it Iterator[result.Result[T]]) result.Result[[]T] {
+	var out []T
+	for v := it.Next(); v.IsSome(); v = it.Next() {
+		r := v.Unwrap()
+		if r.IsErr() {
+			return result.Err[[]T](r.UnwrapErr())
+		}
+		out = append(out, r.Unwrap())
+	}
+	return result.Ok(out)
+}
+
+// Partition drives it to exhaustion, splitting Ok values and Err values into separate slices.
+func Partition[T any](it Iterator[result.Result[T]]) ([]T, []error) {
+	var oks []T
+	var errs []error
+	for v := it.Next(); v.IsSome(); v = it.Next() {
+		r := v.Unwrap()
+		if r.IsErr() {
+			errs = append(errs, r.UnwrapErr())
+		} else {
+			oks = append(oks, r.Unwrap())
+		}
+	}
+	return oks, errs
+}
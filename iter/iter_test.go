@@ -0,0 +1,174 @@
+package iter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/uptutu/result"
+)
+
+func TestFromSliceCollect(t *testing.T) {
+	got := Collect(FromSlice([]int{1, 2, 3}))
+	want := []int{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("Collect = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Collect = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestFromChannel(t *testing.T) {
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	close(ch)
+
+	got := Collect(FromChannel(ch))
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("Collect(FromChannel) = %v, want [1 2]", got)
+	}
+}
+
+func TestMapFilter(t *testing.T) {
+	doubled := Map(FromSlice([]int{1, 2, 3}), func(v int) int { return v * 2 })
+	if got := Collect(doubled); len(got) != 3 || got[2] != 6 {
+		t.Fatalf("Map = %v, want [2 4 6]", got)
+	}
+
+	evens := Filter(FromSlice([]int{1, 2, 3, 4}), func(v int) bool { return v%2 == 0 })
+	if got := Collect(evens); len(got) != 2 || got[0] != 2 || got[1] != 4 {
+		t.Fatalf("Filter = %v, want [2 4]", got)
+	}
+}
+
+func TestTakeBoundaries(t *testing.T) {
+	if got := Collect(Take(FromSlice([]int{1, 2, 3}), 2)); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("Take(2) = %v, want [1 2]", got)
+	}
+	if got := Collect(Take(FromSlice([]int{1, 2, 3}), 0)); len(got) != 0 {
+		t.Fatalf("Take(0) = %v, want []", got)
+	}
+	if got := Collect(Take(FromSlice([]int{1, 2, 3}), -1)); len(got) != 0 {
+		t.Fatalf("Take(-1) = %v, want []", got)
+	}
+	if got := Collect(Take(FromSlice([]int{1, 2, 3}), 10)); len(got) != 3 {
+		t.Fatalf("Take(10) = %v, want [1 2 3]", got)
+	}
+}
+
+func TestSkipBoundaries(t *testing.T) {
+	if got := Collect(Skip(FromSlice([]int{1, 2, 3}), 0)); len(got) != 3 {
+		t.Fatalf("Skip(0) = %v, want [1 2 3]", got)
+	}
+	if got := Collect(Skip(FromSlice([]int{1, 2, 3}), 2)); len(got) != 1 || got[0] != 3 {
+		t.Fatalf("Skip(2) = %v, want [3]", got)
+	}
+	if got := Collect(Skip(FromSlice([]int{1, 2, 3}), 10)); len(got) != 0 {
+		t.Fatalf("Skip(10) = %v, want []", got)
+	}
+}
+
+func TestChain(t *testing.T) {
+	got := Collect(Chain[int](FromSlice([]int{1, 2}), FromSlice([]int{3, 4})))
+	want := []int{1, 2, 3, 4}
+	if len(got) != len(want) {
+		t.Fatalf("Chain = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Chain = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestZipUnequalLengths(t *testing.T) {
+	pairs := Collect(Zip(FromSlice([]int{1, 2, 3}), FromSlice([]string{"a", "b"})))
+	if len(pairs) != 2 {
+		t.Fatalf("Zip with unequal lengths = %v, want 2 pairs", pairs)
+	}
+	if pairs[0].First != 1 || pairs[0].Second != "a" || pairs[1].First != 2 || pairs[1].Second != "b" {
+		t.Fatalf("Zip = %v, want [{1 a} {2 b}]", pairs)
+	}
+
+	if got := Collect(Zip(FromSlice([]int{}), FromSlice([]string{"a"}))); len(got) != 0 {
+		t.Fatalf("Zip with an empty side = %v, want []", got)
+	}
+}
+
+func TestFold(t *testing.T) {
+	sum := Fold(FromSlice([]int{1, 2, 3, 4}), 0, func(acc, v int) int { return acc + v })
+	if sum != 10 {
+		t.Fatalf("Fold = %v, want 10", sum)
+	}
+}
+
+func TestTryFoldShortCircuits(t *testing.T) {
+	errBoom := errors.New("boom")
+	var seen []int
+
+	got := TryFold(FromSlice([]int{1, 2, 3, 4}), 0, func(acc, v int) result.Result[int] {
+		seen = append(seen, v)
+		if v == 3 {
+			return result.Err[int](errBoom)
+		}
+		return result.Ok(acc + v)
+	})
+
+	if !got.IsErr() || !errors.Is(got.UnwrapErr(), errBoom) {
+		t.Fatalf("TryFold = %v, want Err(boom)", got)
+	}
+	if len(seen) != 3 {
+		t.Fatalf("TryFold visited %v, want to stop after the element that errors", seen)
+	}
+}
+
+func TestTryFoldAllOk(t *testing.T) {
+	got := TryFold(FromSlice([]int{1, 2, 3}), 0, func(acc, v int) result.Result[int] {
+		return result.Ok(acc + v)
+	})
+	if got.IsErr() || got.Unwrap() != 6 {
+		t.Fatalf("TryFold = %v, want Ok(6)", got)
+	}
+}
+
+func resultSlice(rs ...result.Result[int]) Iterator[result.Result[int]] {
+	return FromSlice(rs)
+}
+
+func TestTryCollectShortCircuits(t *testing.T) {
+	errBoom := errors.New("boom")
+	it := resultSlice(result.Ok(1), result.Ok(2), result.Err[int](errBoom), result.Ok(4))
+
+	got := TryCollect(it)
+	if !got.IsErr() || !errors.Is(got.UnwrapErr(), errBoom) {
+		t.Fatalf("TryCollect = %v, want Err(boom)", got)
+	}
+}
+
+func TestTryCollectAllOk(t *testing.T) {
+	it := resultSlice(result.Ok(1), result.Ok(2), result.Ok(3))
+	got := TryCollect(it)
+	if got.IsErr() {
+		t.Fatalf("TryCollect = %v, want Ok", got)
+	}
+	vals := got.Unwrap()
+	if len(vals) != 3 || vals[0] != 1 || vals[2] != 3 {
+		t.Fatalf("TryCollect = %v, want [1 2 3]", vals)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	errBoom := errors.New("boom")
+	it := resultSlice(result.Ok(1), result.Err[int](errBoom), result.Ok(3))
+
+	oks, errs := Partition(it)
+	if len(oks) != 2 || oks[0] != 1 || oks[1] != 3 {
+		t.Fatalf("Partition oks = %v, want [1 3]", oks)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], errBoom) {
+		t.Fatalf("Partition errs = %v, want [boom]", errs)
+	}
+}
@@ -0,0 +1,189 @@
+package result
+
+import "fmt"
+
+// Option is a type that represents either the presence of a value (Some) or its absence (None).
+type Option[T any] struct {
+	some  T
+	valid bool
+}
+
+func Some[T any](some T) Option[T] {
+	return Option[T]{some: some, valid: true}
+}
+
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// IsSome returns true if the option is a Some value.
+func (o Option[T]) IsSome() bool {
+	return o.valid
+}
+
+// IsSomeAnd returns true if the option is a Some and the value inside of it matches a predicate.
+func (o Option[T]) IsSomeAnd(f func(T) bool) bool {
+	if o.IsSome() {
+		return f(o.some)
+	}
+	return false
+}
+
+// IsNone returns true if the option is a None value.
+func (o Option[T]) IsNone() bool {
+	return !o.valid
+}
+
+// Expect returns the contained Some value, consuming the self value.
+func (o Option[T]) Expect(msg string) T {
+	if o.IsNone() {
+		panic(msg)
+	}
+	return o.some
+}
+
+// Unwrap returns the contained Some value, consuming the self value.
+// Because this function may panic, its use is generally discouraged. Instead, prefer to use pattern matching and handle the None case explicitly, or call unwrap_or, unwrap_or_else.
+func (o Option[T]) Unwrap() T {
+	if o.IsNone() {
+		panic("called `Option.Unwrap()` on a `None` value")
+	}
+	return o.some
+}
+
+// UnwrapOr returns the contained Some value or a provided default.
+// Arguments passed to unwrap_or are eagerly evaluated; if you are passing the result of a function call, it is recommended to use unwrap_or_else, which is lazily evaluated.
+func (o Option[T]) UnwrapOr(defaultSome T) T {
+	if o.IsNone() {
+		return defaultSome
+	}
+	return o.some
+}
+
+// UnwrapOrElse returns the contained Some value or computes it from a closure.
+func (o Option[T]) UnwrapOrElse(defaultFn func() T) T {
+	if o.IsNone() {
+		return defaultFn()
+	}
+	return o.some
+}
+
+// Map maps an Option[T] to Option[T] by applying a function to a contained Some value, leaving a None value untouched.
+func (o Option[T]) Map(f func(T) T) Option[T] {
+	if o.IsSome() {
+		return Some(f(o.some))
+	}
+	return None[T]()
+}
+
+// OptionMap maps an Option[T] to Option[U] by applying a function to a contained Some value, leaving a None value untouched.
+func OptionMap[T any, U any](o Option[T], f func(T) U) Option[U] {
+	if o.IsSome() {
+		return Some(f(o.some))
+	}
+	return None[U]()
+}
+
+// MapOr returns the provided default (if None), or applies a function to the contained value (if Some).
+// Arguments passed to map_or are eagerly evaluated, even when the option is Some.
+func (o Option[T]) MapOr(defaultSome T, f func(T) T) T {
+	if o.IsSome() {
+		return f(o.some)
+	}
+	return defaultSome
+}
+
+// OptionMapOr returns the provided default (if None), or applies a function to the contained value (if Some).
+// Arguments passed to map_or are eagerly evaluated, even when the option is Some.
+func OptionMapOr[T any, U any](o Option[T], defaultSome U, f func(T) U) U {
+	if o.IsSome() {
+		return f(o.some)
+	}
+	return defaultSome
+}
+
+// AndThen calls op if the option is Some, otherwise returns None.
+// This function can be used for control flow based on Option values.
+func (o Option[T]) AndThen(op func(T) Option[T]) Option[T] {
+	if o.IsNone() {
+		return o
+	}
+	return op(o.some)
+}
+
+// OptionAndThen calls op if the option is Some, otherwise returns None.
+// This function can be used for control flow based on Option values.
+func OptionAndThen[T any, U any](o Option[T], op func(T) Option[U]) Option[U] {
+	if o.IsNone() {
+		return None[U]()
+	}
+	return op(o.some)
+}
+
+// OrElse returns the option if it contains a Some value, otherwise calls op and returns the result.
+func (o Option[T]) OrElse(op func() Option[T]) Option[T] {
+	if o.IsNone() {
+		return op()
+	}
+	return o
+}
+
+// Filter returns None if the option is None, otherwise calls predicate with the contained value and returns:
+// Some(t) if predicate returns true, and None if predicate returns false.
+func (o Option[T]) Filter(predicate func(T) bool) Option[T] {
+	if o.IsSome() && predicate(o.some) {
+		return o
+	}
+	return None[T]()
+}
+
+// Take takes the value out of the option, leaving a None in its place.
+func (o *Option[T]) Take() Option[T] {
+	taken := *o
+	*o = None[T]()
+	return taken
+}
+
+// Replace replaces the actual value in the option by the value given in parameter, returning the old value if present,
+// leaving a Some in its place without deinitializing either one.
+func (o *Option[T]) Replace(some T) Option[T] {
+	old := *o
+	*o = Some(some)
+	return old
+}
+
+// Inspect calls the provided closure with a reference to the contained value (if Some).
+func (o Option[T]) Inspect(f func(T)) Option[T] {
+	if o.IsSome() {
+		f(o.some)
+	}
+	return o
+}
+
+// OptionFlatten converts from Option[Option[T]] to Option[T].
+func OptionFlatten[T any](o Option[Option[T]]) Option[T] {
+	return OptionAndThen(o, func(oo Option[T]) Option[T] { return oo })
+}
+
+// OkOr transforms the Option[T] into a Result[T], mapping Some(v) to Ok(v) and None to Err(err).
+func (o Option[T]) OkOr(err error) Result[T] {
+	if o.IsSome() {
+		return Ok(o.some)
+	}
+	return Err[T](err)
+}
+
+// OkOrElse transforms the Option[T] into a Result[T], mapping Some(v) to Ok(v) and None to Err(errFn()).
+func (o Option[T]) OkOrElse(errFn func() error) Result[T] {
+	if o.IsSome() {
+		return Ok(o.some)
+	}
+	return Err[T](errFn())
+}
+
+func (o Option[T]) String() string {
+	if o.IsNone() {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.some)
+}
@@ -0,0 +1,230 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOptionSomeNone(t *testing.T) {
+	some := Some(1)
+	if !some.IsSome() || some.IsNone() {
+		t.Fatalf("Some(1) = %v, want IsSome", some)
+	}
+	if got := some.Unwrap(); got != 1 {
+		t.Fatalf("Some(1).Unwrap() = %v, want 1", got)
+	}
+
+	none := None[int]()
+	if none.IsSome() || !none.IsNone() {
+		t.Fatalf("None() = %v, want IsNone", none)
+	}
+	if got := none.UnwrapOr(42); got != 42 {
+		t.Fatalf("None().UnwrapOr(42) = %v, want 42", got)
+	}
+}
+
+func TestOptionUnwrapOrElse(t *testing.T) {
+	if got := None[int]().UnwrapOrElse(func() int { return 7 }); got != 7 {
+		t.Fatalf("UnwrapOrElse = %v, want 7", got)
+	}
+	if got := Some(3).UnwrapOrElse(func() int { return 7 }); got != 3 {
+		t.Fatalf("UnwrapOrElse = %v, want 3", got)
+	}
+}
+
+func TestOptionMap(t *testing.T) {
+	got := Some(2).Map(func(v int) int { return v * 10 })
+	if got.Unwrap() != 20 {
+		t.Fatalf("Map = %v, want Some(20)", got)
+	}
+	if got := None[int]().Map(func(v int) int { return v * 10 }); got.IsSome() {
+		t.Fatalf("Map on None = %v, want None", got)
+	}
+}
+
+func TestOptionMapCrossType(t *testing.T) {
+	got := OptionMap(Some(2), func(v int) string {
+		if v == 2 {
+			return "two"
+		}
+		return "other"
+	})
+	if got.Unwrap() != "two" {
+		t.Fatalf("OptionMap = %v, want Some(two)", got)
+	}
+	if got := OptionMap(None[int](), func(v int) string { return "x" }); got.IsSome() {
+		t.Fatalf("OptionMap on None = %v, want None", got)
+	}
+}
+
+func TestOptionMapOr(t *testing.T) {
+	if got := Some(2).MapOr(0, func(v int) int { return v * 10 }); got != 20 {
+		t.Fatalf("MapOr = %v, want 20", got)
+	}
+	if got := None[int]().MapOr(0, func(v int) int { return v * 10 }); got != 0 {
+		t.Fatalf("MapOr = %v, want 0", got)
+	}
+	if got := OptionMapOr(Some(2), "none", func(v int) string { return "some" }); got != "some" {
+		t.Fatalf("OptionMapOr = %v, want some", got)
+	}
+	if got := OptionMapOr(None[int](), "none", func(v int) string { return "some" }); got != "none" {
+		t.Fatalf("OptionMapOr = %v, want none", got)
+	}
+}
+
+func TestOptionAndThen(t *testing.T) {
+	half := func(v int) Option[int] {
+		if v%2 != 0 {
+			return None[int]()
+		}
+		return Some(v / 2)
+	}
+	if got := Some(4).AndThen(half); got.Unwrap() != 2 {
+		t.Fatalf("AndThen = %v, want Some(2)", got)
+	}
+	if got := Some(3).AndThen(half); got.IsSome() {
+		t.Fatalf("AndThen = %v, want None", got)
+	}
+	if got := None[int]().AndThen(half); got.IsSome() {
+		t.Fatalf("AndThen on None = %v, want None", got)
+	}
+}
+
+func TestOptionAndThenCrossType(t *testing.T) {
+	got := OptionAndThen(Some(4), func(v int) Option[string] { return Some("ok") })
+	if got.Unwrap() != "ok" {
+		t.Fatalf("OptionAndThen = %v, want Some(ok)", got)
+	}
+	if got := OptionAndThen(None[int](), func(v int) Option[string] { return Some("ok") }); got.IsSome() {
+		t.Fatalf("OptionAndThen on None = %v, want None", got)
+	}
+}
+
+func TestOptionOrElse(t *testing.T) {
+	if got := Some(1).OrElse(func() Option[int] { return Some(2) }); got.Unwrap() != 1 {
+		t.Fatalf("OrElse = %v, want Some(1)", got)
+	}
+	if got := None[int]().OrElse(func() Option[int] { return Some(2) }); got.Unwrap() != 2 {
+		t.Fatalf("OrElse = %v, want Some(2)", got)
+	}
+}
+
+func TestOptionFilter(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	if got := Some(4).Filter(isEven); got.Unwrap() != 4 {
+		t.Fatalf("Filter = %v, want Some(4)", got)
+	}
+	if got := Some(3).Filter(isEven); got.IsSome() {
+		t.Fatalf("Filter = %v, want None", got)
+	}
+	if got := None[int]().Filter(isEven); got.IsSome() {
+		t.Fatalf("Filter on None = %v, want None", got)
+	}
+}
+
+func TestOptionTake(t *testing.T) {
+	o := Some(5)
+	taken := o.Take()
+	if taken.Unwrap() != 5 {
+		t.Fatalf("Take returned %v, want Some(5)", taken)
+	}
+	if !o.IsNone() {
+		t.Fatalf("after Take, o = %v, want None", o)
+	}
+
+	none := None[int]()
+	takenNone := none.Take()
+	if takenNone.IsSome() || !none.IsNone() {
+		t.Fatalf("Take on None left %v / %v, want both None", takenNone, none)
+	}
+}
+
+func TestOptionReplace(t *testing.T) {
+	o := Some(1)
+	old := o.Replace(2)
+	if old.Unwrap() != 1 {
+		t.Fatalf("Replace returned old = %v, want Some(1)", old)
+	}
+	if o.Unwrap() != 2 {
+		t.Fatalf("after Replace, o = %v, want Some(2)", o)
+	}
+
+	none := None[int]()
+	old = none.Replace(9)
+	if old.IsSome() || none.Unwrap() != 9 {
+		t.Fatalf("Replace on None left old = %v, o = %v, want None / Some(9)", old, none)
+	}
+}
+
+func TestOptionInspect(t *testing.T) {
+	var seen int
+	Some(5).Inspect(func(v int) { seen = v })
+	if seen != 5 {
+		t.Fatalf("Inspect did not observe value, got %v", seen)
+	}
+	seen = 0
+	None[int]().Inspect(func(v int) { seen = v })
+	if seen != 0 {
+		t.Fatalf("Inspect ran on None, want no-op")
+	}
+}
+
+func TestOptionFlatten(t *testing.T) {
+	if got := OptionFlatten(Some(Some(1))); got.Unwrap() != 1 {
+		t.Fatalf("OptionFlatten(Some(Some(1))) = %v, want Some(1)", got)
+	}
+	if got := OptionFlatten(Some(None[int]())); got.IsSome() {
+		t.Fatalf("OptionFlatten(Some(None)) = %v, want None", got)
+	}
+	if got := OptionFlatten(None[Option[int]]()); got.IsSome() {
+		t.Fatalf("OptionFlatten(None) = %v, want None", got)
+	}
+}
+
+func TestOptionOkOr(t *testing.T) {
+	errBoom := errors.New("boom")
+	if got := Some(1).OkOr(errBoom); got.IsErr() || got.Unwrap() != 1 {
+		t.Fatalf("OkOr on Some = %v, want Ok(1)", got)
+	}
+	if got := None[int]().OkOr(errBoom); !got.IsErr() || got.UnwrapErr() != errBoom {
+		t.Fatalf("OkOr on None = %v, want Err(boom)", got)
+	}
+}
+
+func TestOptionOkOrElse(t *testing.T) {
+	errBoom := errors.New("boom")
+	called := false
+	errFn := func() error { called = true; return errBoom }
+
+	if got := Some(1).OkOrElse(errFn); got.IsErr() || got.Unwrap() != 1 {
+		t.Fatalf("OkOrElse on Some = %v, want Ok(1)", got)
+	}
+	if called {
+		t.Fatal("OkOrElse evaluated errFn on a Some value")
+	}
+	if got := None[int]().OkOrElse(errFn); !got.IsErr() || got.UnwrapErr() != errBoom {
+		t.Fatalf("OkOrElse on None = %v, want Err(boom)", got)
+	}
+	if !called {
+		t.Fatal("OkOrElse did not evaluate errFn on a None value")
+	}
+}
+
+func TestResultOkErrInterop(t *testing.T) {
+	ok := Ok(10)
+	if got := ok.Ok(); got.IsNone() || got.Unwrap() != 10 {
+		t.Fatalf("Ok(10).Ok() = %v, want Some(10)", got)
+	}
+	if got := ok.Err(); got.IsSome() {
+		t.Fatalf("Ok(10).Err() = %v, want None", got)
+	}
+
+	errBoom := errors.New("boom")
+	errRes := Err[int](errBoom)
+	if got := errRes.Ok(); got.IsSome() {
+		t.Fatalf("Err(boom).Ok() = %v, want None", got)
+	}
+	if got := errRes.Err(); got.IsNone() || got.Unwrap() != errBoom {
+		t.Fatalf("Err(boom).Err() = %v, want Some(boom)", got)
+	}
+}
@@ -52,17 +52,20 @@ func (r Result[T]) IsErrAnd(f func(error) bool) bool {
 	return false
 }
 
-// Ok returns T, and returns empty if it is an error.
-func (r Result[T]) Ok() *T {
+// Ok converts from Result[T] to Option[T], discarding the error, if any.
+func (r Result[T]) Ok() Option[T] {
 	if r.IsOk() {
-		return &r.ok
+		return Some(r.ok)
 	}
-	return nil
+	return None[T]()
 }
 
-// Err returns error.
-func (r Result[T]) Err() error {
-	return r.err
+// Err converts from Result[T] to Option[error], discarding the Ok value, if any.
+func (r Result[T]) Err() Option[error] {
+	if r.IsErr() {
+		return Some(r.err)
+	}
+	return None[error]()
 }
 
 // ErrVal returns error inner value.
@@ -0,0 +1,85 @@
+// Package try provides panic/recover-based error propagation over Result[T],
+// letting call sites unwind straight-line code on the first error instead of
+// threading `if err != nil` checks through every step.
+package try
+
+import (
+	"github.com/uptutu/result"
+)
+
+// panicErr is the sentinel wrapper used to carry an error through a panic/recover pair.
+// It is unexported so that a foreign panic (one not raised by this package) is never
+// mistaken for a propagated error and silently absorbed by Handle/HandleF/Recover.
+type panicErr struct {
+	err error
+}
+
+// E unwraps r, returning the Ok value. If r is an Err, it panics with the wrapped error;
+// pair it with a deferred Handle (or HandleF) to convert the panic back into a named
+// return error.
+func E[T any](r result.Result[T]) T {
+	if r.IsErr() {
+		panic(panicErr{err: r.UnwrapErr()})
+	}
+	return r.Unwrap()
+}
+
+// E2 unwraps a (T, U, error)-style call site. If err is non-nil,
+// it panics with the wrapped error; otherwise it returns t and u unchanged.
+func E2[T any, U any](t T, u U, err error) (T, U) {
+	if err != nil {
+		panic(panicErr{err: err})
+	}
+	return t, u
+}
+
+// E3 unwraps a (T, U, V, error)-style call site. If err is non-nil, it panics with the
+// wrapped error; otherwise it returns t, u and v unchanged.
+func E3[T any, U any, V any](t T, u U, v V, err error) (T, U, V) {
+	if err != nil {
+		panic(panicErr{err: err})
+	}
+	return t, u, v
+}
+
+// Handle is a defer-friendly recoverer: it recovers a panic raised by E/E2/E3 and assigns
+// the wrapped error to *err, the named return of the calling function. Any other panic
+// is re-panicked unchanged.
+func Handle(err *error) {
+	if r := recover(); r != nil {
+		pe, ok := r.(panicErr)
+		if !ok {
+			panic(r)
+		}
+		*err = pe.err
+	}
+}
+
+// HandleF behaves like Handle, additionally invoking f after assigning *err, once a
+// propagated error has been recovered. f is not called when there is nothing to recover,
+// or when the recovered value is not a propagated error.
+func HandleF(err *error, f func()) {
+	if r := recover(); r != nil {
+		pe, ok := r.(panicErr)
+		if !ok {
+			panic(r)
+		}
+		*err = pe.err
+		f()
+	}
+}
+
+// Recover runs f and converts any panic raised by E/E2/E3 inside it into an Err result.
+// Any other panic is re-panicked unchanged.
+func Recover[T any](f func() result.Result[T]) (res result.Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			pe, ok := r.(panicErr)
+			if !ok {
+				panic(r)
+			}
+			res = result.Err[T](pe.err)
+		}
+	}()
+	return f()
+}
@@ -0,0 +1,126 @@
+package try
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/uptutu/result"
+)
+
+func TestEOkAndErr(t *testing.T) {
+	if got := E(result.Ok(5)); got != 5 {
+		t.Fatalf("E(Ok(5)) = %v, want 5", got)
+	}
+
+	errBoom := errors.New("boom")
+	func() {
+		var err error
+		defer Handle(&err)
+		E(result.Err[int](errBoom))
+		t.Fatal("E did not panic on Err")
+	}()
+}
+
+func TestE2E3(t *testing.T) {
+	a, b := E2(1, "x", nil)
+	if a != 1 || b != "x" {
+		t.Fatalf("E2 = (%v, %v), want (1, x)", a, b)
+	}
+
+	a2, b2, c2 := E3(1, "x", true, nil)
+	if a2 != 1 || b2 != "x" || !c2 {
+		t.Fatalf("E3 = (%v, %v, %v), want (1, x, true)", a2, b2, c2)
+	}
+
+	errBoom := errors.New("boom")
+	var err error
+	func() {
+		defer Handle(&err)
+		E2(1, "x", errBoom)
+	}()
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("Handle after E2 = %v, want %v", err, errBoom)
+	}
+}
+
+func TestHandleRecoversSentinelPanic(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	run := func() (err error) {
+		defer Handle(&err)
+		E(result.Err[int](errBoom))
+		return nil
+	}
+
+	if got := run(); !errors.Is(got, errBoom) {
+		t.Fatalf("Handle recovered = %v, want %v", got, errBoom)
+	}
+}
+
+func TestHandleFRunsCallbackOnRecover(t *testing.T) {
+	errBoom := errors.New("boom")
+	called := false
+
+	run := func() (err error) {
+		defer HandleF(&err, func() { called = true })
+		E(result.Err[int](errBoom))
+		return nil
+	}
+
+	if got := run(); !errors.Is(got, errBoom) {
+		t.Fatalf("HandleF recovered = %v, want %v", got, errBoom)
+	}
+	if !called {
+		t.Fatal("HandleF did not invoke its callback")
+	}
+}
+
+func TestHandleRepanicsForeignPanic(t *testing.T) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("foreign panic was swallowed instead of re-panicking")
+		}
+		if _, ok := r.(panicErr); ok {
+			t.Fatal("foreign panic was mistaken for a sentinel panicErr")
+		}
+		if r != "not a try panic" {
+			t.Fatalf("re-panicked value = %v, want %q", r, "not a try panic")
+		}
+	}()
+
+	func() {
+		var err error
+		defer Handle(&err)
+		panic("not a try panic")
+	}()
+}
+
+func TestRecover(t *testing.T) {
+	ok := Recover(func() result.Result[int] {
+		return result.Ok(E(result.Ok(3)) + 1)
+	})
+	if ok.IsErr() || ok.Unwrap() != 4 {
+		t.Fatalf("Recover = %v, want Ok(4)", ok)
+	}
+
+	errBoom := errors.New("boom")
+	errRes := Recover(func() result.Result[int] {
+		return result.Ok(E(result.Err[int](errBoom)))
+	})
+	if !errRes.IsErr() || !errors.Is(errRes.UnwrapErr(), errBoom) {
+		t.Fatalf("Recover = %v, want Err(boom)", errRes)
+	}
+}
+
+func TestRecoverRepanicsForeignPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "not a try panic" {
+			t.Fatalf("recovered %v, want foreign panic to propagate", r)
+		}
+	}()
+
+	Recover(func() result.Result[int] {
+		panic("not a try panic")
+	})
+}